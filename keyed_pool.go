@@ -0,0 +1,243 @@
+package pool
+
+import (
+	"sync"
+	"time"
+)
+
+// KeyedPool is a collection of Pool[T] sub-pools, one per key, for resources parameterized by
+// a key (e.g. per-host HTTP clients, per-shard DB connections, per-tenant caches). A sub-pool
+// is created lazily the first time a key is seen, and every builder option set on the
+// KeyedPool is forwarded to all of its sub-pools, existing and future.
+type KeyedPool[K comparable, T any] struct {
+	mu         sync.RWMutex
+	pools      map[K]*Pool[T]
+	sizePerKey int
+	maker      func(K) (T, error)
+	options    []func(*Pool[T])
+	destroyer  func(T)
+}
+
+// Create a new keyed pool. sizePerKey is the max number of objects pooled per key.
+// maker is the function that generates a new object for a given key.
+func NewKeyedFixedPool[K comparable, T any](sizePerKey int, maker func(K) (T, error)) *KeyedPool[K, T] {
+	if maker == nil {
+		panic("Need maker function")
+	}
+	return &KeyedPool[K, T]{
+		pools:      make(map[K]*Pool[T]),
+		sizePerKey: sizePerKey,
+		maker:      maker,
+	}
+}
+
+// addOption records a builder option and applies it to every sub-pool created so far; it is
+// also applied to sub-pools created later, in poolFor.
+func (v *KeyedPool[K, T]) addOption(opt func(*Pool[T])) *KeyedPool[K, T] {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.options = append(v.options, opt)
+	for _, p := range v.pools {
+		opt(p)
+	}
+	return v
+}
+
+// See Pool.WithTester. Forwarded to every sub-pool.
+func (v *KeyedPool[K, T]) WithTester(tester func(T) bool) *KeyedPool[K, T] {
+	return v.addOption(func(p *Pool[T]) { p.WithTester(tester) })
+}
+
+// See Pool.WithDestroyer. Forwarded to every sub-pool, and also kept on the KeyedPool itself to
+// destroy a value Returned under a key that was never Borrowed (so never got a sub-pool).
+func (v *KeyedPool[K, T]) WithDestroyer(destroyer func(T)) *KeyedPool[K, T] {
+	v.destroyer = destroyer
+	return v.addOption(func(p *Pool[T]) { p.WithDestroyer(destroyer) })
+}
+
+// See Pool.WithIdleTimeout. Forwarded to every sub-pool.
+func (v *KeyedPool[K, T]) WithIdleTimeout(seconds int) *KeyedPool[K, T] {
+	return v.addOption(func(p *Pool[T]) { p.WithIdleTimeout(seconds) })
+}
+
+// See Pool.WithMinIdle. Forwarded to every sub-pool.
+func (v *KeyedPool[K, T]) WithMinIdle(minIdle int) *KeyedPool[K, T] {
+	return v.addOption(func(p *Pool[T]) { p.WithMinIdle(minIdle) })
+}
+
+// See Pool.WithMaxIdle. Forwarded to every sub-pool.
+func (v *KeyedPool[K, T]) WithMaxIdle(maxIdle int) *KeyedPool[K, T] {
+	return v.addOption(func(p *Pool[T]) { p.WithMaxIdle(maxIdle) })
+}
+
+// See Pool.WithTimeBetweenEvictionRuns. Forwarded to every sub-pool.
+func (v *KeyedPool[K, T]) WithTimeBetweenEvictionRuns(d time.Duration) *KeyedPool[K, T] {
+	return v.addOption(func(p *Pool[T]) { p.WithTimeBetweenEvictionRuns(d) })
+}
+
+// See Pool.WithNumTestsPerEvictionRun. Forwarded to every sub-pool.
+func (v *KeyedPool[K, T]) WithNumTestsPerEvictionRun(n int) *KeyedPool[K, T] {
+	return v.addOption(func(p *Pool[T]) { p.WithNumTestsPerEvictionRun(n) })
+}
+
+// See Pool.WithMinEvictableIdleTime. Forwarded to every sub-pool.
+func (v *KeyedPool[K, T]) WithMinEvictableIdleTime(d time.Duration) *KeyedPool[K, T] {
+	return v.addOption(func(p *Pool[T]) { p.WithMinEvictableIdleTime(d) })
+}
+
+// See Pool.WithSoftMinEvictableIdleTime. Forwarded to every sub-pool.
+func (v *KeyedPool[K, T]) WithSoftMinEvictableIdleTime(d time.Duration) *KeyedPool[K, T] {
+	return v.addOption(func(p *Pool[T]) { p.WithSoftMinEvictableIdleTime(d) })
+}
+
+// See Pool.WithTestWhileIdle. Forwarded to every sub-pool.
+func (v *KeyedPool[K, T]) WithTestWhileIdle(testWhileIdle bool) *KeyedPool[K, T] {
+	return v.addOption(func(p *Pool[T]) { p.WithTestWhileIdle(testWhileIdle) })
+}
+
+// See Pool.WithActivator. Forwarded to every sub-pool.
+func (v *KeyedPool[K, T]) WithActivator(activator func(T) error) *KeyedPool[K, T] {
+	return v.addOption(func(p *Pool[T]) { p.WithActivator(activator) })
+}
+
+// See Pool.WithPassivator. Forwarded to every sub-pool.
+func (v *KeyedPool[K, T]) WithPassivator(passivator func(T) error) *KeyedPool[K, T] {
+	return v.addOption(func(p *Pool[T]) { p.WithPassivator(passivator) })
+}
+
+// See Pool.WithLIFO. Forwarded to every sub-pool.
+func (v *KeyedPool[K, T]) WithLIFO(lifo bool) *KeyedPool[K, T] {
+	return v.addOption(func(p *Pool[T]) { p.WithLIFO(lifo) })
+}
+
+// See Pool.WithBlockWhenExhausted. Forwarded to every sub-pool.
+func (v *KeyedPool[K, T]) WithBlockWhenExhausted(blockWhenExhausted bool) *KeyedPool[K, T] {
+	return v.addOption(func(p *Pool[T]) { p.WithBlockWhenExhausted(blockWhenExhausted) })
+}
+
+// See Pool.WithTestOnCreate. Forwarded to every sub-pool.
+func (v *KeyedPool[K, T]) WithTestOnCreate(testOnCreate bool) *KeyedPool[K, T] {
+	return v.addOption(func(p *Pool[T]) { p.WithTestOnCreate(testOnCreate) })
+}
+
+// See Pool.WithTestOnBorrow. Forwarded to every sub-pool.
+func (v *KeyedPool[K, T]) WithTestOnBorrow(testOnBorrow bool) *KeyedPool[K, T] {
+	return v.addOption(func(p *Pool[T]) { p.WithTestOnBorrow(testOnBorrow) })
+}
+
+// See Pool.WithTestOnReturn. Forwarded to every sub-pool.
+func (v *KeyedPool[K, T]) WithTestOnReturn(testOnReturn bool) *KeyedPool[K, T] {
+	return v.addOption(func(p *Pool[T]) { p.WithTestOnReturn(testOnReturn) })
+}
+
+// poolFor returns the sub-pool for key, lazily creating one (with all configured options
+// applied) the first time the key is seen.
+func (v *KeyedPool[K, T]) poolFor(key K) *Pool[T] {
+	v.mu.RLock()
+	p, ok := v.pools[key]
+	v.mu.RUnlock()
+	if ok {
+		return p
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if p, ok := v.pools[key]; ok {
+		return p
+	}
+	p = NewFixedPool(v.sizePerKey, func() (T, error) { return v.maker(key) })
+	for _, opt := range v.options {
+		opt(p)
+	}
+	v.pools[key] = p
+	return p
+}
+
+// Borrow an object for key, lazily creating the sub-pool for key on first use.
+func (v *KeyedPool[K, T]) Borrow(key K) (T, error) {
+	return v.poolFor(key).Borrow()
+}
+
+// Return an object for key to its sub-pool.
+// Returns true if returned successfully, matching Pool.Return. If key was never Borrowed (so
+// never got a sub-pool), Return does not create one just to hold c: it destroys c (if a
+// destroyer is configured) and returns false, the same way Pool.Return handles an object it
+// can't enqueue.
+func (v *KeyedPool[K, T]) Return(key K, c T) bool {
+	v.mu.RLock()
+	p, ok := v.pools[key]
+	v.mu.RUnlock()
+	if !ok {
+		if v.destroyer != nil {
+			v.destroyer(c)
+		}
+		return false
+	}
+	return p.Return(c)
+}
+
+// Clear closes and removes the sub-pool for key, if one exists for it.
+func (v *KeyedPool[K, T]) Clear(key K) {
+	v.mu.Lock()
+	p, ok := v.pools[key]
+	delete(v.pools, key)
+	v.mu.Unlock()
+	if ok {
+		p.Close()
+	}
+}
+
+// Close closes every sub-pool and removes all keys.
+func (v *KeyedPool[K, T]) Close() error {
+	v.mu.Lock()
+	pools := v.pools
+	v.pools = make(map[K]*Pool[T])
+	v.mu.Unlock()
+	for _, p := range pools {
+		p.Close()
+	}
+	return nil
+}
+
+// Stats is a snapshot of a single sub-pool's counters.
+type Stats struct {
+	Created   int64
+	Borrowed  int64
+	Destroyed int64
+	Tested    int64
+	Returned  int64
+}
+
+func statsOf[T any](p *Pool[T]) Stats {
+	return Stats{
+		Created:   p.CreatedCount(),
+		Borrowed:  p.BorrowedCount(),
+		Destroyed: p.DestroyedCount(),
+		Tested:    p.TestedCount(),
+		Returned:  p.ReturnedCount(),
+	}
+}
+
+// KeyedStats reports counters per key, plus Total summed across every key.
+type KeyedStats[K comparable] struct {
+	PerKey map[K]Stats
+	Total  Stats
+}
+
+// Stats returns a snapshot of every sub-pool's counters, per-key and totalled.
+func (v *KeyedPool[K, T]) Stats() KeyedStats[K] {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	perKey := make(map[K]Stats, len(v.pools))
+	var total Stats
+	for k, p := range v.pools {
+		s := statsOf(p)
+		perKey[k] = s
+		total.Created += s.Created
+		total.Borrowed += s.Borrowed
+		total.Destroyed += s.Destroyed
+		total.Tested += s.Tested
+		total.Returned += s.Returned
+	}
+	return KeyedStats[K]{PerKey: perKey, Total: total}
+}
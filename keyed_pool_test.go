@@ -0,0 +1,45 @@
+package pool
+
+import (
+	"testing"
+)
+
+// TestKeyedPoolPerKeyIsolation checks that each key gets its own sub-pool and that Stats
+// reports both per-key and totalled counters.
+func TestKeyedPoolPerKeyIsolation(t *testing.T) {
+	kp := NewKeyedFixedPool(2, func(host string) (string, error) {
+		return host + "-conn", nil
+	})
+
+	a, err := kp.Borrow("a")
+	if err != nil {
+		t.Fatalf("Borrow(a) failed: %v", err)
+	}
+	if a != "a-conn" {
+		t.Fatalf("expected a-conn, got %s", a)
+	}
+
+	b, err := kp.Borrow("b")
+	if err != nil {
+		t.Fatalf("Borrow(b) failed: %v", err)
+	}
+	if b != "b-conn" {
+		t.Fatalf("expected b-conn, got %s", b)
+	}
+
+	kp.Return("a", a)
+	kp.Return("b", b)
+
+	stats := kp.Stats()
+	if stats.PerKey["a"].Borrowed != 1 || stats.PerKey["b"].Borrowed != 1 {
+		t.Fatalf("expected each key to report its own Borrowed count, got %+v", stats.PerKey)
+	}
+	if stats.Total.Borrowed != 2 {
+		t.Fatalf("expected Total.Borrowed 2, got %d", stats.Total.Borrowed)
+	}
+
+	kp.Clear("a")
+	if _, ok := kp.Stats().PerKey["a"]; ok {
+		t.Fatalf("expected key a to be removed after Clear")
+	}
+}
@@ -1,28 +1,62 @@
 package pool
 
 import (
+	"context"
+	"errors"
 	"time"
 	"sync/atomic"
 	"sync"
 )
 
-// Stores a single pooled object. It also stores the time it was last validated
+// Stores a single pooled object. It tracks the time it was last validated (for idleTimeout
+// revalidation on Borrow) and the time it was last placed into the idle queue (for the evictor).
 type element[T any] struct {
 	data          T
 	lastValidated time.Time
+	lastUsed      time.Time
 }
 
+// ErrPoolClosed is returned by Borrow/BorrowContext/BorrowTimeout once the pool has been
+// closed via Close or CloseContext.
+var ErrPoolClosed = errors.New("pool: closed")
+
 type Pool[T any] struct {
-	queue       chan element[T]
-	created     int64
-	borrowed    int64
-	destroyed   int64
-	tested      int64
-	returned    int64
-	idleTimeout time.Duration
-	maker       func() (T,error)
-	tester      func(T) bool
-	destroyer   func(T)
+	mu                       sync.Mutex
+	items                    []element[T]
+	waitCh                   chan struct{}
+	closed                   bool
+	capacity                 int
+	maxIdle                  int
+	minIdle                  int
+	created                  int64
+	borrowed                 int64
+	destroyed                int64
+	tested                   int64
+	returned                 int64
+	timeoutCount             int64
+	waitCount                int64
+	waitDurationTotal        int64
+	idleTimeout              time.Duration
+	blockWhenExhausted       bool
+	timeBetweenEvictionRuns  time.Duration
+	numTestsPerEvictionRun   int
+	minEvictableIdleTime     time.Duration
+	softMinEvictableIdleTime time.Duration
+	testWhileIdle            bool
+	lifo                     bool
+	testOnCreate             bool
+	testOnBorrow             bool
+	testOnReturn             bool
+	activatedCount           int64
+	passivatedCount          int64
+	validationFailureCount   int64
+	evictTicker              *time.Ticker
+	evictStop                chan struct{}
+	maker                    func() (T,error)
+	tester                   func(T) bool
+	destroyer                func(T)
+	activator                func(T) error
+	passivator               func(T) error
 }
 
 // Set the tester function of the object. When set, objects exceeded idleTimeout will be revalidated before returning
@@ -39,6 +73,43 @@ func (v *Pool[T]) WithDestroyer(destroyer func(T)) *Pool[T] {
 	return v
 }
 
+// Function run inside Borrow/BorrowContext/BorrowTimeout, right before an object is handed to
+// the caller (after the idle-timeout/tester step and any TestOnBorrow check). If it returns an
+// error the object is destroyed and one replacement is made via maker before giving up.
+func (v *Pool[T]) WithActivator(activator func(T) error) *Pool[T] {
+	v.activator = activator
+	return v
+}
+
+// Function run inside Return, before the object is placed back in the idle queue. If it
+// returns an error the object is destroyed instead of pooled.
+func (v *Pool[T]) WithPassivator(passivator func(T) error) *Pool[T] {
+	v.passivator = passivator
+	return v
+}
+
+// When true, tester (if configured) is run once against every freshly made object before it is
+// placed in the idle queue by PreFill or the evictor's top-up, independent of idleTimeout.
+// An object that fails is destroyed and replaced once via maker; that replacement is not tested.
+func (v *Pool[T]) WithTestOnCreate(testOnCreate bool) *Pool[T] {
+	v.testOnCreate = testOnCreate
+	return v
+}
+
+// When true, tester (if configured) is run against every object about to be handed out by
+// Borrow/BorrowContext/BorrowTimeout, independent of idleTimeout.
+func (v *Pool[T]) WithTestOnBorrow(testOnBorrow bool) *Pool[T] {
+	v.testOnBorrow = testOnBorrow
+	return v
+}
+
+// When true, tester (if configured) is run against every object passed to Return, independent
+// of idleTimeout. Objects that fail are destroyed instead of pooled.
+func (v *Pool[T]) WithTestOnReturn(testOnReturn bool) *Pool[T] {
+	v.testOnReturn = testOnReturn
+	return v
+}
+
 // Objects idled for more than this time (seconds) will be revalidated via validator upon Borrow().
 // If no validator provided, object will be discarded and Borrow() will
 // return freshly made one via maker function
@@ -47,6 +118,74 @@ func (v *Pool[T]) WithIdleTimeout(seconds int) *Pool[T] {
 	return v
 }
 
+// When true, Borrow() blocks (indefinitely, like BorrowContext(context.Background())) once
+// the pool is at capacity (created - destroyed >= pool size) instead of calling maker directly.
+// Default is false, matching the historical behavior of Borrow() always making a new object
+// on an empty queue.
+func (v *Pool[T]) WithBlockWhenExhausted(block bool) *Pool[T] {
+	v.blockWhenExhausted = block
+	return v
+}
+
+// Minimum number of idle objects the evictor tries to keep in the pool by calling maker
+// in the background after each eviction run. Default 0 (no top-up).
+func (v *Pool[T]) WithMinIdle(minIdle int) *Pool[T] {
+	v.minIdle = minIdle
+	return v
+}
+
+// Maximum number of idle objects kept in the pool. Return() destroys the object instead of
+// queueing it once this many are already idle. Defaults to the pool size.
+func (v *Pool[T]) WithMaxIdle(maxIdle int) *Pool[T] {
+	v.maxIdle = maxIdle
+	return v
+}
+
+// How often the background evictor scans idle objects. Starts (or restarts) the evictor
+// goroutine; a zero or negative duration leaves the evictor disabled, which is the default.
+func (v *Pool[T]) WithTimeBetweenEvictionRuns(d time.Duration) *Pool[T] {
+	v.timeBetweenEvictionRuns = d
+	v.startEvictor()
+	return v
+}
+
+// Maximum number of idle objects inspected per evictor run. Zero or negative means inspect
+// all of them.
+func (v *Pool[T]) WithNumTestsPerEvictionRun(n int) *Pool[T] {
+	v.numTestsPerEvictionRun = n
+	return v
+}
+
+// Objects idle for at least this long are destroyed by the evictor (after TestWhileIdle
+// revalidation, if configured). Zero disables this check.
+func (v *Pool[T]) WithMinEvictableIdleTime(d time.Duration) *Pool[T] {
+	v.minEvictableIdleTime = d
+	return v
+}
+
+// Like MinEvictableIdleTime, but only takes effect while the idle count exceeds MinIdle, so
+// the evictor never shrinks the pool below MinIdle just because objects are old. Zero disables
+// this check.
+func (v *Pool[T]) WithSoftMinEvictableIdleTime(d time.Duration) *Pool[T] {
+	v.softMinEvictableIdleTime = d
+	return v
+}
+
+// When true, the evictor runs tester against each idle object it inspects and destroys any
+// that fail, independent of MinEvictableIdleTime/SoftMinEvictableIdleTime.
+func (v *Pool[T]) WithTestWhileIdle(testWhileIdle bool) *Pool[T] {
+	v.testWhileIdle = testWhileIdle
+	return v
+}
+
+// When true (the default, matching commons-pool), Borrow returns the most recently returned
+// object (LIFO), leaving colder objects at the tail of the idle queue for the evictor to
+// reclaim. Set false for FIFO ordering instead.
+func (v *Pool[T]) WithLIFO(lifo bool) *Pool[T] {
+	v.lifo = lifo
+	return v
+}
+
 // Create a new fixed pool. size is the max number of object to pool
 // maker is the function that generates new object for the pool (when pool is empty)
 func NewFixedPool[T any](size int, maker func() (T, error)) *Pool[T] {
@@ -55,7 +194,11 @@ func NewFixedPool[T any](size int, maker func() (T, error)) *Pool[T] {
 		panic("Need maker function")
 	}
 	result := &Pool[T]{
-		queue:       make(chan element[T], size),
+		items:       make([]element[T], 0, size),
+		waitCh:      make(chan struct{}),
+		capacity:    size,
+		maxIdle:     size,
+		lifo:        true,
 		created:     0,
 		destroyed:   0,
 		tested:      0,
@@ -80,11 +223,11 @@ func (v *Pool[T]) wrapMaker() (T, error) {
 }
 
 func (v *Pool[T]) wrapDestroyer(what T) {
-	if v.destroyer == nil {
-		return
+	if v.destroyer != nil {
+		v.destroyer(what)
 	}
-	v.destroyer(what)
 	atomic.AddInt64(&v.destroyed, 1)
+	v.notifyWaiters()
 }
 
 func (v *Pool[T]) wrapTester(what T) bool {
@@ -94,27 +237,124 @@ func (v *Pool[T]) wrapTester(what T) bool {
 	atomic.AddInt64(&v.tested, 1)
 	return v.tester(what)
 }
+
+// createForIdle makes a new object destined for the idle queue (PreFill, the evictor's
+// top-up), applying TestOnCreate if configured. A failing object is destroyed and replaced once.
+func (v *Pool[T]) createForIdle() (T, error) {
+	made, err := v.wrapMaker()
+	if err != nil {
+		return made, err
+	}
+	if v.testOnCreate && v.tester != nil {
+		atomic.AddInt64(&v.tested, 1)
+		if !v.tester(made) {
+			atomic.AddInt64(&v.validationFailureCount, 1)
+			v.wrapDestroyer(made)
+			return v.wrapMaker()
+		}
+	}
+	return made, nil
+}
+
+// live returns the number of objects currently alive, whether idle in the queue or borrowed out.
+func (v *Pool[T]) live() int64 {
+	return atomic.LoadInt64(&v.created) - atomic.LoadInt64(&v.destroyed)
+}
+
+// outstanding returns the number of objects currently borrowed out and not yet returned.
+func (v *Pool[T]) outstanding() int64 {
+	return atomic.LoadInt64(&v.borrowed) - atomic.LoadInt64(&v.returned)
+}
+
+func (v *Pool[T]) isClosed() bool {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.closed
+}
+
+// enqueue places data into the idle queue, unless it is already at MaxIdle capacity or the
+// pool has been closed.
+func (v *Pool[T]) enqueue(data T) bool {
+	v.mu.Lock()
+	if v.closed || len(v.items) >= v.maxIdle {
+		v.mu.Unlock()
+		return false
+	}
+	now := time.Now()
+	v.items = append(v.items, element[T]{data, now, now})
+	v.mu.Unlock()
+	v.notifyWaiters()
+	return true
+}
+
+// popIdle removes and returns the next object from the idle queue, if any: the most recently
+// returned one in LIFO mode (the default), or the oldest one in FIFO mode.
+func (v *Pool[T]) popIdle() (element[T], bool) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.popIdleLocked()
+}
+
+// popIdleLocked is popIdle's implementation, assuming v.mu is already held by the caller.
+func (v *Pool[T]) popIdleLocked() (element[T], bool) {
+	n := len(v.items)
+	if n == 0 {
+		return element[T]{}, false
+	}
+	if v.lifo {
+		c := v.items[n-1]
+		v.items = v.items[:n-1]
+		return c, true
+	}
+	c := v.items[0]
+	v.items = v.items[1:]
+	return c, true
+}
+
+// tryReserveCapacity atomically checks whether the pool has spare capacity and, if so, reserves
+// the slot by bumping created — so the capacity check and the reservation happen as one atomic
+// step and concurrent BorrowContext callers can't all observe spare room and each make an
+// object, exceeding the pool's size. Callers must roll the reservation back (via
+// atomic.AddInt64(&v.created, -1)) if the subsequent maker() call fails.
+func (v *Pool[T]) tryReserveCapacity() bool {
+	for {
+		created := atomic.LoadInt64(&v.created)
+		destroyed := atomic.LoadInt64(&v.destroyed)
+		if created-destroyed >= int64(v.capacity) {
+			return false
+		}
+		if atomic.CompareAndSwapInt64(&v.created, created, created+1) {
+			return true
+		}
+	}
+}
+
+func (v *Pool[T]) notifyWaiters() {
+	v.mu.Lock()
+	old := v.waitCh
+	v.waitCh = make(chan struct{})
+	v.mu.Unlock()
+	close(old)
+}
+
 // Prepopulate the pool with full elements. This will call the maker repeately until it is full
 // Failed maker will be discarded. If maker never return successful result, this may be in dead loop
 func (v *Pool[T]) PreFill() int {
 	var madeCount int32 = 0
 	var wg sync.WaitGroup
 
-	for i:=0; i < cap(v.queue); i++ {
+	for i:=0; i < v.capacity; i++ {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			made, err := v.wrapMaker()
+			made, err := v.createForIdle()
 			if err != nil {
 				return
 			}
-			elem := element[T]{made, time.Now()}
-			select {
-			case v.queue <- elem:
+			if v.enqueue(made) {
 				atomic.AddInt32(&madeCount, 1)
-				return
-			default:
-			    v.wrapDestroyer(elem.data)
+			} else {
+				v.wrapDestroyer(made)
 			}
 		}()
 	}
@@ -122,10 +362,96 @@ func (v *Pool[T]) PreFill() int {
 	return int(madeCount)
 }
 
+// Revalidate (and possibly replace) an element popped off the queue, applying the same
+// idleTimeout/tester/destroyer policy used by Borrow() and BorrowContext().
+func (v *Pool[T]) processDequeued(c element[T]) (T, error) {
+	data := c.data
+	now := time.Now()
+	elapsed := now.Sub(c.lastValidated)
+	if elapsed < v.idleTimeout {
+		// no need to revalidate again yet
+		return data, nil
+	}
+	if v.tester != nil {
+		// the thing may need to be validated again
+		if v.wrapTester(data) {
+			// the object is still good
+			return data, nil
+		}
+		v.wrapDestroyer(data)
+		return v.wrapMaker()
+	}
+	// objects are discarded directly
+	v.wrapDestroyer(data)
+	for j := 0; j < 2; j++ {
+		r, e := v.wrapMaker()
+		if e != nil {
+			time.Sleep(time.Second)
+		} else {
+			return r, e
+		}
+	}
+	return v.wrapMaker()
+}
+
+// prepareForBorrow runs TestOnBorrow and the Activator against an object about to be handed
+// to a Borrow/BorrowContext caller. A failing object is destroyed and replaced (once) via maker;
+// if the replacement fails too, it is destroyed and an error is returned.
+func (v *Pool[T]) prepareForBorrow(data T) (T, error) {
+	for attempt := 0; attempt < 2; attempt++ {
+		if v.testOnBorrow && v.tester != nil {
+			atomic.AddInt64(&v.tested, 1)
+			if !v.tester(data) {
+				atomic.AddInt64(&v.validationFailureCount, 1)
+				v.wrapDestroyer(data)
+				if attempt > 0 {
+					var zero T
+					return zero, errors.New("pool: object failed TestOnBorrow")
+				}
+				made, err := v.wrapMaker()
+				if err != nil {
+					return made, err
+				}
+				data = made
+				continue
+			}
+		}
+		if v.activator != nil {
+			if err := v.activator(data); err != nil {
+				atomic.AddInt64(&v.validationFailureCount, 1)
+				v.wrapDestroyer(data)
+				if attempt > 0 {
+					var zero T
+					return zero, err
+				}
+				made, err2 := v.wrapMaker()
+				if err2 != nil {
+					return made, err2
+				}
+				data = made
+				continue
+			}
+			atomic.AddInt64(&v.activatedCount, 1)
+		}
+		return data, nil
+	}
+	return data, nil
+}
+
 // Borrow a object from the pool, returns immediately if one is available
 // If an object failed test upon checkout because of tester func fails, a new object will be made and returned
 // Pool will not retry making. If you want to retry, retry in your maker function
+// If WithBlockWhenExhausted(true) was set, Borrow blocks (like BorrowContext(context.Background()))
+// once the pool is at capacity instead of making a new object immediately.
+// Returns ErrPoolClosed once the pool has been closed via Close/CloseContext.
 func (v *Pool[T]) Borrow() (T, error) {
+	if v.isClosed() {
+		var zero T
+		return zero, ErrPoolClosed
+	}
+	if v.blockWhenExhausted {
+		return v.BorrowContext(context.Background())
+	}
 	result, err := v.borrowInternal()
 	if err == nil {
 		atomic.AddInt64(&v.borrowed, 1)
@@ -134,60 +460,268 @@ func (v *Pool[T]) Borrow() (T, error) {
 }
 
 func (v *Pool[T]) borrowInternal() (T, error) {
-	select {
-	case c := <-v.queue:
-		data := c.data
-		now := time.Now()
-		elapsed := now.Sub(c.lastValidated)
-		if elapsed >= v.idleTimeout {
-			if v.tester != nil {
-				// the thing may need to be validated again
-				if v.wrapTester(data) {
-					// the object is still good
-					return data, nil
-				} else {
-					v.wrapDestroyer(data)
-					return v.wrapMaker()
-				}
-			} else {
-				// objects are discarded directly
-				v.wrapDestroyer(data)
-				for j := 0; j < 2; j++ {
-					r, e := v.wrapMaker()
-					if e != nil {
-						time.Sleep(time.Second)
-					} else {
-						return r, e
-					}
-				}
-				return v.wrapMaker()
+	if c, ok := v.popIdle(); ok {
+		data, err := v.processDequeued(c)
+		if err != nil {
+			return data, err
+		}
+		return v.prepareForBorrow(data)
+	}
+	data, err := v.wrapMaker()
+	if err != nil {
+		return data, err
+	}
+	return v.prepareForBorrow(data)
+}
+
+// Borrow an object, blocking until one becomes available, the context is done, or (once the
+// pool is at capacity: created - destroyed >= pool size) a returned object frees up a slot.
+// Returns ctx.Err() (context.DeadlineExceeded or context.Canceled) if ctx is done first, or
+// ErrPoolClosed if the pool is (or becomes) closed via Close/CloseContext while waiting.
+func (v *Pool[T]) BorrowContext(ctx context.Context) (T, error) {
+	for {
+		if v.isClosed() {
+			var zero T
+			return zero, ErrPoolClosed
+		}
+
+		// Check the idle queue and, if it's empty, snapshot the wait channel in the same
+		// critical section. Both sides of enqueue (append the item, then close/replace waitCh)
+		// take v.mu too, so whichever of the two it's in the middle of, we either already see
+		// the item here or we're guaranteed to observe the close of the exact channel we just
+		// captured. Splitting this into two lock acquisitions would leave a window where a
+		// concurrent Return lands in between and the waiter registers on the fresh waitCh,
+		// missing the notification entirely (a lost wakeup).
+		v.mu.Lock()
+		c, ok := v.popIdleLocked()
+		ch := v.waitCh
+		v.mu.Unlock()
+		if ok {
+			result, err := v.processDequeued(c)
+			if err == nil {
+				result, err = v.prepareForBorrow(result)
 			}
-		} else {
-			// no need to revalidate again yet
-			return data, nil
+			if err == nil {
+				atomic.AddInt64(&v.borrowed, 1)
+			}
+			return result, err
+		}
+
+		if v.tryReserveCapacity() {
+			result, err := v.maker()
+			if err != nil {
+				atomic.AddInt64(&v.created, -1)
+				return result, err
+			}
+			result, err = v.prepareForBorrow(result)
+			if err == nil {
+				atomic.AddInt64(&v.borrowed, 1)
+			}
+			return result, err
+		}
+		atomic.AddInt64(&v.waitCount, 1)
+		start := time.Now()
+		select {
+		case <-ch:
+			atomic.AddInt64(&v.waitDurationTotal, int64(time.Since(start)))
+			// an object was returned, destroyed, or topped up: loop and retry
+		case <-ctx.Done():
+			atomic.AddInt64(&v.waitDurationTotal, int64(time.Since(start)))
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				atomic.AddInt64(&v.timeoutCount, 1)
+			}
+			var zero T
+			return zero, ctx.Err()
 		}
-	default:
-		return v.wrapMaker()
 	}
 }
 
+// Borrow an object, blocking for up to d waiting for one to become available. Equivalent to
+// BorrowContext with a context.WithTimeout(d), returning context.DeadlineExceeded on expiry.
+func (v *Pool[T]) BorrowTimeout(d time.Duration) (T, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), d)
+	defer cancel()
+	return v.BorrowContext(ctx)
+}
+
 // Return an object to the pool, the object doesn't has to be borrowed
 // Returns true if returned successfully
 // Returns false if pool is full and object had been discarded
 // If a destroyer is defined, the object will be destroyed
 // (which is unlikely unless you returned something extra to the pool)
+// Once the pool has been closed via Close/CloseContext, Return always destroys c instead of
+// enqueuing it.
 func (v *Pool[T]) Return(c T) bool {
-	elem := element[T]{c, time.Now()}
 	atomic.AddInt64(&v.returned, 1)
-	select {
-	case v.queue <- elem:
-		return true
-	default:
+	if v.isClosed() {
 		v.wrapDestroyer(c)
 		return false
 	}
+	if v.passivator != nil {
+		if err := v.passivator(c); err != nil {
+			atomic.AddInt64(&v.validationFailureCount, 1)
+			v.wrapDestroyer(c)
+			return false
+		}
+		atomic.AddInt64(&v.passivatedCount, 1)
+	}
+	if v.testOnReturn && v.tester != nil {
+		atomic.AddInt64(&v.tested, 1)
+		if !v.tester(c) {
+			atomic.AddInt64(&v.validationFailureCount, 1)
+			v.wrapDestroyer(c)
+			return false
+		}
+	}
+	if v.enqueue(c) {
+		return true
+	}
+	v.wrapDestroyer(c)
+	return false
+}
+
+// startEvictor (re)starts the background eviction goroutine according to the current
+// TimeBetweenEvictionRuns. A non-positive duration leaves the evictor stopped.
+func (v *Pool[T]) startEvictor() {
+	v.stopEvictor()
+	if v.timeBetweenEvictionRuns <= 0 {
+		return
+	}
+	ticker := time.NewTicker(v.timeBetweenEvictionRuns)
+	stop := make(chan struct{})
+	v.evictTicker = ticker
+	v.evictStop = stop
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				v.runEvictionPass()
+			case <-stop:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+}
+
+func (v *Pool[T]) stopEvictor() {
+	if v.evictStop != nil {
+		close(v.evictStop)
+		v.evictStop = nil
+		v.evictTicker = nil
+	}
+}
+
+// runEvictionPass inspects up to NumTestsPerEvictionRun idle objects, destroying ones that are
+// past MinEvictableIdleTime (or SoftMinEvictableIdleTime while idle count exceeds MinIdle) or
+// that fail TestWhileIdle, then tops the idle queue back up to MinIdle.
+func (v *Pool[T]) runEvictionPass() {
+	now := time.Now()
+
+	v.mu.Lock()
+	n := v.numTestsPerEvictionRun
+	if n <= 0 || n > len(v.items) {
+		n = len(v.items)
+	}
+	idleCount := len(v.items)
+	survivors := make([]element[T], 0, len(v.items))
+	var toDestroy []T
+	for i, c := range v.items {
+		if i >= n {
+			survivors = append(survivors, c)
+			continue
+		}
+		age := now.Sub(c.lastUsed)
+		evict := (v.minEvictableIdleTime > 0 && age >= v.minEvictableIdleTime) ||
+			(v.softMinEvictableIdleTime > 0 && age >= v.softMinEvictableIdleTime && idleCount > v.minIdle)
+		if v.testWhileIdle && v.tester != nil {
+			atomic.AddInt64(&v.tested, 1)
+			if !v.tester(c.data) {
+				evict = true
+			}
+		}
+		if evict {
+			toDestroy = append(toDestroy, c.data)
+			idleCount--
+			continue
+		}
+		survivors = append(survivors, c)
+	}
+	v.items = survivors
+	deficit := v.minIdle - len(v.items)
+	v.mu.Unlock()
+
+	for _, data := range toDestroy {
+		v.wrapDestroyer(data)
+	}
+	if deficit > 0 {
+		go v.topUp(deficit)
+	}
+}
+
+// topUp asynchronously creates up to n replacement objects to bring the idle queue back up
+// towards MinIdle, never exceeding the pool's overall capacity.
+func (v *Pool[T]) topUp(n int) {
+	for i := 0; i < n; i++ {
+		if v.live() >= int64(v.capacity) {
+			return
+		}
+		made, err := v.createForIdle()
+		if err != nil {
+			return
+		}
+		if !v.enqueue(made) {
+			v.wrapDestroyer(made)
+			return
+		}
+	}
+}
+
+// Close marks the pool closed (subsequent Borrow/BorrowContext/BorrowTimeout return
+// ErrPoolClosed, and Return destroys rather than enqueues), stops the background evictor (if
+// running), and destroys every object currently idle in the pool via the configured destroyer.
+// Objects already borrowed out are left alone; use CloseContext to wait for them to come back.
+func (v *Pool[T]) Close() error {
+	v.mu.Lock()
+	v.closed = true
+	items := v.items
+	v.items = nil
+	v.mu.Unlock()
+
+	v.stopEvictor()
+	for _, c := range items {
+		v.wrapDestroyer(c.data)
+	}
+	v.notifyWaiters()
+	return nil
 }
 
+// CloseContext closes the pool like Close, then additionally waits for every object still
+// borrowed out to be returned (and thereby destroyed, since the pool is now closed), up to ctx.
+// Returns ctx.Err() if ctx is done before all outstanding objects come back.
+func (v *Pool[T]) CloseContext(ctx context.Context) error {
+	if err := v.Close(); err != nil {
+		return err
+	}
+	for {
+		// Capture the wait channel and re-check outstanding() under a single held lock, the
+		// same pattern BorrowContext uses: otherwise a Return of the last outstanding object
+		// landing between the two could close/rotate waitCh before we capture it, and we'd
+		// select on a channel that will never close.
+		v.mu.Lock()
+		if v.outstanding() <= 0 {
+			v.mu.Unlock()
+			return nil
+		}
+		ch := v.waitCh
+		v.mu.Unlock()
+		select {
+		case <-ch:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
 
 func (v *Pool[T]) CreatedCount() int64 {
 	return v.created
@@ -208,3 +742,33 @@ func (v *Pool[T]) ReturnedCount() int64 {
 func (v *Pool[T]) BorrowedCount() int64 {
 	return v.borrowed
 }
+
+// Number of times BorrowContext/BorrowTimeout gave up waiting because the context expired
+func (v *Pool[T]) TimeoutCount() int64 {
+	return v.timeoutCount
+}
+
+// Number of times a caller had to wait (pool was at capacity with no idle object available)
+func (v *Pool[T]) WaitCount() int64 {
+	return v.waitCount
+}
+
+// Total time callers have spent waiting in BorrowContext/BorrowTimeout
+func (v *Pool[T]) WaitDurationTotal() time.Duration {
+	return time.Duration(v.waitDurationTotal)
+}
+
+// Number of times Activator ran successfully against an object handed out by Borrow
+func (v *Pool[T]) ActivatedCount() int64 {
+	return v.activatedCount
+}
+
+// Number of times Passivator ran successfully against an object passed to Return
+func (v *Pool[T]) PassivatedCount() int64 {
+	return v.passivatedCount
+}
+
+// Number of times an object failed Activator, Passivator, or a TestOnCreate/TestOnBorrow/TestOnReturn check
+func (v *Pool[T]) ValidationFailureCount() int64 {
+	return v.validationFailureCount
+}
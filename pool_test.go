@@ -2,15 +2,13 @@ package pool
 
 
 import (
+	"context"
 	"testing"
 	"math/rand"
 	"time"
 	"fmt"
 )
 
-var rands = rand.NewSource(time.Now().UnixNano())
-var randSource = rand.New(rands)
-
 // TestHelloName calls greetings.Hello with a name, checking
 // for a valid return value.
 func TestPool(t *testing.T) {
@@ -38,9 +36,178 @@ func TestPool(t *testing.T) {
 	}
 }
 
+// TestBorrowContextBlocks checks that BorrowContext waits for a returned object instead of
+// making a new one once the pool is at capacity, and gives up when the context expires.
+func TestBorrowContextBlocks(t *testing.T) {
+	p := NewFixedPool(1, instantMaker).WithBlockWhenExhausted(true)
+	first, err := p.Borrow()
+	if err != nil {
+		t.Fatalf("Borrow failed: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if _, err := p.BorrowContext(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+	if p.TimeoutCount() != 1 {
+		t.Fatalf("expected TimeoutCount 1, got %d", p.TimeoutCount())
+	}
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		p.Return(first)
+	}()
+	second, err := p.BorrowTimeout(1 * time.Second)
+	if err != nil {
+		t.Fatalf("BorrowTimeout failed: %v", err)
+	}
+	if second != first {
+		t.Fatalf("expected the returned object %d back, got %d", first, second)
+	}
+	if p.WaitCount() < 1 {
+		t.Fatalf("expected WaitCount >= 1, got %d", p.WaitCount())
+	}
+}
+
+// TestEvictorReapsIdleObjects checks that the background evictor destroys objects that have
+// been idle past MinEvictableIdleTime.
+func TestEvictorReapsIdleObjects(t *testing.T) {
+	p := NewFixedPool(5, instantMaker).
+		WithDestroyer(destroyer).
+		WithMinIdle(2).
+		WithMinEvictableIdleTime(50 * time.Millisecond).
+		WithTimeBetweenEvictionRuns(20 * time.Millisecond)
+	defer p.Close()
+
+	time.Sleep(200 * time.Millisecond)
+
+	if p.DestroyedCount() == 0 {
+		t.Fatalf("expected the evictor to have destroyed some idle objects, got 0")
+	}
+}
+
+// TestLIFOOrdering checks that, in the default LIFO mode, Borrow hands back the most recently
+// returned object rather than the one that has been idle the longest.
+func TestLIFOOrdering(t *testing.T) {
+	p := NewFixedPool(3, instantMaker)
+	drain(p)
+	p.Return(1)
+	p.Return(2)
+	p.Return(3)
+
+	got, err := p.Borrow()
+	if err != nil {
+		t.Fatalf("Borrow failed: %v", err)
+	}
+	if got != 3 {
+		t.Fatalf("expected LIFO order to hand back the last returned object 3, got %d", got)
+	}
+
+	fifo := NewFixedPool(3, instantMaker).WithLIFO(false)
+	drain(fifo)
+	fifo.Return(1)
+	fifo.Return(2)
+	fifo.Return(3)
+	got, err = fifo.Borrow()
+	if err != nil {
+		t.Fatalf("Borrow failed: %v", err)
+	}
+	if got != 1 {
+		t.Fatalf("expected FIFO order to hand back the first returned object 1, got %d", got)
+	}
+}
+
+// drain empties a pool's idle queue, discarding whatever PreFill put there.
+func drain(p *Pool[int]) {
+	for i := 0; i < p.capacity; i++ {
+		p.Borrow()
+	}
+}
+
+// TestActivatePassivate checks that Activator runs before an object is handed out, Passivator
+// runs before it is pooled, and that a failing Passivator causes the object to be destroyed.
+func TestActivatePassivate(t *testing.T) {
+	var activated, passivated int
+	p := NewFixedPool(2, instantMaker).
+		WithDestroyer(destroyer).
+		WithActivator(func(v int) error {
+			activated++
+			return nil
+		}).
+		WithPassivator(func(v int) error {
+			passivated++
+			if v == 99 {
+				return fmt.Errorf("poisoned: %d", v)
+			}
+			return nil
+		})
+
+	v, err := p.Borrow()
+	if err != nil {
+		t.Fatalf("Borrow failed: %v", err)
+	}
+	if activated == 0 {
+		t.Fatalf("expected Activator to have run at least once")
+	}
+
+	before := p.DestroyedCount()
+	if p.Return(99) {
+		t.Fatalf("expected Return(99) to fail because Passivator rejects it")
+	}
+	if p.DestroyedCount() != before+1 {
+		t.Fatalf("expected the poisoned object to be destroyed")
+	}
+
+	p.Return(v)
+	if passivated == 0 {
+		t.Fatalf("expected Passivator to have run at least once")
+	}
+}
+
+// TestCloseDrainsAndRejects checks that Close destroys idle objects, rejects further Borrow
+// calls with ErrPoolClosed, and that CloseContext waits for an outstanding object to come back.
+func TestCloseDrainsAndRejects(t *testing.T) {
+	p := NewFixedPool(2, instantMaker).WithDestroyer(destroyer)
+	borrowed, err := p.Borrow()
+	if err != nil {
+		t.Fatalf("Borrow failed: %v", err)
+	}
+
+	before := p.DestroyedCount()
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		p.Return(borrowed)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := p.CloseContext(ctx); err != nil {
+		t.Fatalf("CloseContext failed: %v", err)
+	}
+	// Close destroys the one idle object; the returned object must be destroyed as well.
+	if p.DestroyedCount() < before+2 {
+		t.Fatalf("expected the idle and the returned object to be destroyed after close, got %d destroyed", p.DestroyedCount()-before)
+	}
+
+	if _, err := p.Borrow(); err != ErrPoolClosed {
+		t.Fatalf("expected ErrPoolClosed, got %v", err)
+	}
+	if p.Return(99) {
+		t.Fatalf("expected Return to fail on a closed pool")
+	}
+}
+
+// instantMaker and maker use the top-level math/rand functions (backed by a lock-protected
+// global source) rather than a private *rand.Rand, since PreFill calls maker from v.capacity
+// concurrent goroutines and *rand.Rand is not itself safe for concurrent use.
+func instantMaker() (int, error) {
+	return rand.Intn(100), nil
+}
+
 func maker() (int, error) {
 	time.Sleep(5 * time.Second)
-	result := randSource.Intn(100)
+	result := rand.Intn(100)
 	fmt.Printf("Making number: %d\n", result)
 	return result, nil
 }